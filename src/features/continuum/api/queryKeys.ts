@@ -2,6 +2,7 @@
  * TanStack Query Key Factory (Simplified for MVP)
  */
 
+import { normalizeTransactionHash } from '@/shared/utils/shared/validation'
 import type { RecentTicksParams } from './types'
 
 /**
@@ -29,7 +30,8 @@ export const queryKeys = {
    */
   transactions: {
     all: () => ['transactions'] as const,
-    detail: (hash: string) => [...queryKeys.transactions.all(), hash] as const,
+    detail: (hash: string) =>
+      [...queryKeys.transactions.all(), normalizeTransactionHash(hash)] as const,
   },
 
   /**
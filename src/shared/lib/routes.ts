@@ -2,6 +2,7 @@
 // Both use the same API_BASE_URL
 
 import { makeApiUrl } from './api';
+import { normalizeTransactionHash } from '@/shared/utils/shared/validation';
 
 /**
  * Continuum API Routes
@@ -19,7 +20,7 @@ export const continuumRoutes = {
     return makeApiUrl(`/api/v1/continuum/ticks${qs ? `?${qs}` : ''}`);
   },
   RECENT_TICKS: (limit: number) => makeApiUrl(`/api/v1/continuum/tick/recent?limit=${limit}`),
-  TX: (hash: string) => makeApiUrl(`/api/v1/continuum/tx/${hash}`),
+  TX: (hash: string) => makeApiUrl(`/api/v1/continuum/tx/${normalizeTransactionHash(hash)}`),
   RECENT_TX: (limit: number) => makeApiUrl(`/api/v1/continuum/tx/recent?limit=${limit}`),
   STREAM_TICKS: (params?: { start_tick?: number }) => {
     const search = new URLSearchParams();
@@ -28,7 +29,7 @@ export const continuumRoutes = {
     return makeApiUrl(`/api/v1/continuum/stream-ticks${qs ? `?${qs}` : ''}`);
   },
   // New Continuum API endpoints
-  TXN: (txnId: string) => makeApiUrl(`/api/v1/continuum/txn/${txnId}`),
+  TXN: (txnId: string) => makeApiUrl(`/api/v1/continuum/txn/${normalizeTransactionHash(txnId)}`),
   RECENT_TXN: (limit: number = 50) => makeApiUrl(`/api/v1/continuum/txn/recent?limit=${limit}`),
 } as const;
 
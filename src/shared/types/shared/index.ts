@@ -29,6 +29,7 @@ export type {
 
 export type {
   ValidationError,
+  ValidationCode,
   ErrorResponse,
   RequestLimits,
   TickValidationResult,
@@ -8,7 +8,7 @@
 export interface ValidationError {
   field: string
   message: string
-  code: string
+  code: ValidationCode
 }
 
 /**
@@ -31,6 +31,7 @@ export interface RequestLimits {
   timeout: number
   maxTickNumber: bigint
   maxRecentTicks: number
+  txHashLengths: number[]
 }
 
 /**
@@ -45,7 +46,6 @@ export interface TickValidationResult {
  * Common validation patterns
  */
 export const ValidationPatterns = {
-  TRANSACTION_HASH: /^[a-fA-F0-9]{8}$/,
   HEX_STRING: /^[a-fA-F0-9]+$/,
 } as const
 
@@ -60,6 +60,8 @@ export const ValidationErrorCodes = {
   TOO_SMALL: 'too_small',
 } as const
 
+export type ValidationCode = (typeof ValidationErrorCodes)[keyof typeof ValidationErrorCodes]
+
 /**
  * HTTP status codes for common errors
  */
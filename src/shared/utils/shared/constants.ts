@@ -1,3 +1,5 @@
+import { ValidationErrorCodes } from '@/shared/types/shared/validation'
+
 /**
  * Shared constants for Continuum Explorer
  */
@@ -26,20 +28,15 @@ export const WEBSOCKET_CONFIG = {
  * Validation patterns
  */
 export const VALIDATION_PATTERNS = {
-  TRANSACTION_HASH: /^[a-fA-F0-9]{8}$/,
   HEX_STRING: /^[a-fA-F0-9]+$/,
   UUID: /^[0-9a-f]{8}-[0-9a-f]{4}-[1-5][0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$/i,
 } as const
 
 /**
- * Error codes
+ * Error codes (validation codes come from the shared ValidationErrorCodes)
  */
 export const ERROR_CODES = {
-  REQUIRED: 'required',
-  INVALID_FORMAT: 'invalid_format',
-  OUT_OF_RANGE: 'out_of_range',
-  TOO_LARGE: 'too_large',
-  TOO_SMALL: 'too_small',
+  ...ValidationErrorCodes,
   NETWORK_ERROR: 'network_error',
   TIMEOUT: 'timeout',
   UNAUTHORIZED: 'unauthorized',
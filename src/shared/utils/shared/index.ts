@@ -38,6 +38,7 @@ export {
   validateTransactionHash,
   validateTickNumber,
   isValidTransactionHash,
+  normalizeTransactionHash,
   isValidTickNumber,
   sanitizeInput,
 } from './validation'
\ No newline at end of file
@@ -0,0 +1,83 @@
+import { readdirSync, readFileSync, statSync } from 'node:fs'
+import { join, relative } from 'node:path'
+import { fileURLToPath } from 'node:url'
+import { describe, expect, it } from 'vitest'
+import {
+  DEFAULT_LIMITS,
+  isValidTransactionHash,
+  normalizeTransactionHash,
+  validateTransactionHash,
+} from './validation'
+
+const hex = (length: number) => 'a1b2c3d4e5f6'.repeat(6).slice(0, length)
+
+describe('validateTransactionHash', () => {
+  it.each([
+    { name: '8-char short hash', hash: hex(8), valid: true },
+    { name: '64-char full hash', hash: hex(64), valid: true },
+    { name: 'uppercase short hash', hash: hex(8).toUpperCase(), valid: true },
+    { name: 'mixed-case full hash', hash: `${hex(32).toUpperCase()}${hex(32)}`, valid: true },
+    { name: '7 chars', hash: hex(7), valid: false },
+    { name: '9 chars', hash: hex(9), valid: false },
+    { name: '63 chars', hash: hex(63), valid: false },
+    { name: '65 chars', hash: hex(65), valid: false },
+    { name: 'non-hex characters', hash: 'zzzzzzzz', valid: false },
+    { name: 'non-hex full hash', hash: `${hex(63)}g`, valid: false },
+  ])('$name', ({ hash, valid }) => {
+    expect(isValidTransactionHash(hash)).toBe(valid)
+    expect(validateTransactionHash(hash) === null).toBe(valid)
+  })
+
+  it('requires a hash', () => {
+    expect(validateTransactionHash('')?.code).toBe('required')
+  })
+
+  it('lists the accepted lengths in the error message', () => {
+    const error = validateTransactionHash(hex(9))
+    expect(error?.code).toBe('invalid_format')
+    expect(error?.message).toBe('Transaction hash must be 8 or 64 hexadecimal characters')
+  })
+
+  it('honours custom txHashLengths', () => {
+    const limits = { ...DEFAULT_LIMITS, txHashLengths: [16] }
+    expect(validateTransactionHash(hex(16), limits)).toBeNull()
+    expect(validateTransactionHash(hex(8), limits)?.message).toBe(
+      'Transaction hash must be 16 hexadecimal characters',
+    )
+    expect(isValidTransactionHash(hex(64), limits)).toBe(false)
+  })
+})
+
+describe('normalizeTransactionHash', () => {
+  it.each([
+    { name: 'lowercases a short hash', input: 'ABCDEF12', expected: 'abcdef12' },
+    { name: 'lowercases a full hash', input: hex(64).toUpperCase(), expected: hex(64) },
+    { name: 'leaves non-hash ids unchanged', input: 'Tx-ABC', expected: 'Tx-ABC' },
+  ])('$name', ({ input, expected }) => {
+    expect(normalizeTransactionHash(input)).toBe(expected)
+  })
+})
+
+describe('validation codes', () => {
+  const srcDir = fileURLToPath(new URL('../../../', import.meta.url))
+  const allowed = new Set([
+    'shared/utils/shared/constants.ts',
+    'shared/types/shared/validation.ts',
+  ])
+
+  const sourceFiles = (dir: string): string[] =>
+    readdirSync(dir).flatMap((entry) => {
+      const path = join(dir, entry)
+      if (statSync(path).isDirectory()) return sourceFiles(path)
+      return /\.tsx?$/.test(entry) ? [path] : []
+    })
+
+  it('are only constructed from the constants', () => {
+    const offenders = sourceFiles(srcDir)
+      .map((path) => relative(srcDir, path).split('\\').join('/'))
+      .filter((path) => !allowed.has(path))
+      .filter((path) => /\bcode:\s*['"`]/.test(readFileSync(join(srcDir, path), 'utf8')))
+
+    expect(offenders).toEqual([])
+  })
+})
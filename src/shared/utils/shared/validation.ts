@@ -1,10 +1,13 @@
+import type { ValidationCode } from '@/shared/types/shared/validation'
+import { ERROR_CODES } from './constants'
+
 /**
  * Local validation error structure (mirrored from shared-types)
  */
 interface ValidationError {
   field: string
   message: string
-  code: string
+  code: ValidationCode
 }
 
 export interface RequestLimits {
@@ -13,6 +16,7 @@ export interface RequestLimits {
   timeout: number
   maxTickNumber: bigint
   maxRecentTicks: number
+  txHashLengths: number[]
 }
 
 /**
@@ -28,25 +32,34 @@ export const DEFAULT_LIMITS: RequestLimits = {
   timeout: 30000, // 30 seconds
   maxTickNumber: 1000000000n, // 1 billion
   maxRecentTicks: 1000,
+  txHashLengths: [8, 64], // short hash and full 32-byte hash
+}
+
+/**
+ * Normalize transaction hash for lookups and cache keys.
+ * Values that are not valid hashes (e.g. transaction IDs) are returned unchanged.
+ */
+export function normalizeTransactionHash(hash: string, limits = DEFAULT_LIMITS): string {
+  return isValidTransactionHash(hash, limits) ? hash.toLowerCase() : hash
 }
 
 /**
  * Validate transaction hash format
  */
-export function validateTransactionHash(hash: string): ValidationError | null {
+export function validateTransactionHash(hash: string, limits = DEFAULT_LIMITS): ValidationError | null {
   if (!hash) {
     return {
       field: 'hash',
       message: 'Transaction hash is required',
-      code: 'required'
+      code: ERROR_CODES.REQUIRED
     }
   }
 
-  if (!/^[a-fA-F0-9]{8}$/.test(hash)) {
+  if (!isValidTransactionHash(hash, limits)) {
     return {
       field: 'hash',
-      message: 'Transaction hash must be exactly 8 hexadecimal characters',
-      code: 'invalid_format'
+      message: `Transaction hash must be ${limits.txHashLengths.join(' or ')} hexadecimal characters`,
+      code: ERROR_CODES.INVALID_FORMAT
     }
   }
 
@@ -63,7 +76,7 @@ export function validateTickNumber(tickStr: string, limits = DEFAULT_LIMITS): {
       error: {
         field: 'number',
         message: 'Tick number is required',
-        code: 'required'
+        code: ERROR_CODES.REQUIRED
       }
     }
   }
@@ -77,7 +90,7 @@ export function validateTickNumber(tickStr: string, limits = DEFAULT_LIMITS): {
       error: {
         field: 'number',
         message: 'Tick number must be a valid positive integer',
-        code: 'invalid_format'
+        code: ERROR_CODES.INVALID_FORMAT
       }
     }
   }
@@ -88,7 +101,7 @@ export function validateTickNumber(tickStr: string, limits = DEFAULT_LIMITS): {
       error: {
         field: 'number',
         message: 'Tick number must be positive',
-        code: 'invalid_format'
+        code: ERROR_CODES.INVALID_FORMAT
       }
     }
   }
@@ -99,7 +112,7 @@ export function validateTickNumber(tickStr: string, limits = DEFAULT_LIMITS): {
       error: {
         field: 'number',
         message: `Tick number must not exceed ${limits.maxTickNumber}`,
-        code: 'out_of_range'
+        code: ERROR_CODES.OUT_OF_RANGE
       }
     }
   }
@@ -124,19 +137,19 @@ export function validatePaginationParams(
       errors.push({
         field: 'limit',
         message: 'Limit must be a valid integer',
-        code: 'invalid_format'
+        code: ERROR_CODES.INVALID_FORMAT
       })
     } else if (limit < 1) {
       errors.push({
         field: 'limit',
         message: 'Limit must be greater than 0',
-        code: 'out_of_range'
+        code: ERROR_CODES.OUT_OF_RANGE
       })
     } else if (limit > limits.maxRecentTicks) {
       errors.push({
         field: 'limit',
         message: `Limit must not exceed ${limits.maxRecentTicks}`,
-        code: 'out_of_range'
+        code: ERROR_CODES.OUT_OF_RANGE
       })
     }
   }
@@ -150,7 +163,7 @@ export function validatePaginationParams(
       errors.push({
         field: 'offset',
         message: 'Offset must be a valid non-negative integer',
-        code: 'invalid_format'
+        code: ERROR_CODES.INVALID_FORMAT
       })
       return errors
     }
@@ -159,13 +172,13 @@ export function validatePaginationParams(
       errors.push({
         field: 'offset',
         message: 'Offset must be non-negative',
-        code: 'invalid_format'
+        code: ERROR_CODES.INVALID_FORMAT
       })
     } else if (offset > limits.maxTickNumber) {
       errors.push({
         field: 'offset',
         message: `Offset must not exceed ${limits.maxTickNumber}`,
-        code: 'out_of_range'
+        code: ERROR_CODES.OUT_OF_RANGE
       })
     }
   }
@@ -183,8 +196,8 @@ export function sanitizeInput(input: string): string {
 /**
  * Check if transaction hash format is valid
  */
-export function isValidTransactionHash(hash: string): boolean {
-  return /^[a-fA-F0-9]{8}$/.test(hash)
+export function isValidTransactionHash(hash: string, limits = DEFAULT_LIMITS): boolean {
+  return limits.txHashLengths.includes(hash.length) && isValidHexString(hash)
 }
 
 /**